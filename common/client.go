@@ -0,0 +1,182 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+)
+
+// DatabricksClient holds properties needed to authenticate and talk to a Databricks workspace's REST API.
+type DatabricksClient struct {
+	Host        string
+	Token       string
+	UserAgent   string
+	RetryPolicy RetryPolicy
+	client      *http.Client
+}
+
+// APIErrorBody maps to the standard `{"error_code": ..., "message": ...}` payload Databricks
+// returns on non-2xx responses.
+type APIErrorBody struct {
+	ErrorCode string `json:"error_code,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+// APIError is the Go error wrapping a non-2xx Databricks REST API response.
+type APIError struct {
+	APIErrorBody
+	StatusCode int
+}
+
+func (e APIError) Error() string {
+	return e.Message
+}
+
+// ErrCanceled wraps a request aborted because its context was canceled or its deadline
+// elapsed, so callers can distinguish "server said no" (APIError) from "we gave up waiting".
+type ErrCanceled struct {
+	Err error
+}
+
+func (e *ErrCanceled) Error() string {
+	return fmt.Sprintf("request canceled: %v", e.Err)
+}
+
+func (e *ErrCanceled) Unwrap() error {
+	return e.Err
+}
+
+// NewClientFromEnvironment builds a DatabricksClient from the DATABRICKS_HOST / DATABRICKS_TOKEN
+// environment variables, the same convention used by acceptance tests and the CLI.
+func NewClientFromEnvironment() *DatabricksClient {
+	return &DatabricksClient{
+		Host:  os.Getenv("DATABRICKS_HOST"),
+		Token: os.Getenv("DATABRICKS_TOKEN"),
+		client: &http.Client{},
+	}
+}
+
+// CommonEnvironmentClient is a convenience wrapper used by acceptance tests to obtain a
+// ready-to-use client from the ambient environment.
+func CommonEnvironmentClient() *DatabricksClient {
+	return NewClientFromEnvironment()
+}
+
+func (c *DatabricksClient) httpClient() *http.Client {
+	if c.client == nil {
+		c.client = &http.Client{}
+	}
+	return c.client
+}
+
+func (c *DatabricksClient) retryPolicy() RetryPolicy {
+	if c.RetryPolicy == nil {
+		return DefaultRetryPolicy{}
+	}
+	return c.RetryPolicy
+}
+
+func (c *DatabricksClient) authenticatedRequest(ctx context.Context, method, path string, body interface{}, response interface{}) error {
+	var reqBody []byte
+	var err error
+	if body != nil {
+		reqBody, err = json.Marshal(body)
+		if err != nil {
+			return err
+		}
+	}
+
+	policy := c.retryPolicy()
+	var lastErr error
+	for attempt := 0; attempt < policy.MaxAttempts(); attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return &ErrCanceled{Err: ctx.Err()}
+			case <-time.After(policy.Backoff(attempt - 1)):
+			}
+		}
+
+		req, err := http.NewRequest(method, c.Host+path, bytes.NewReader(reqBody))
+		if err != nil {
+			return err
+		}
+		req = req.WithContext(ctx)
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := c.httpClient().Do(req)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return &ErrCanceled{Err: ctxErr}
+			}
+			return err
+		}
+		respBody, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode >= 400 {
+			var errBody APIErrorBody
+			_ = json.Unmarshal(respBody, &errBody)
+			apiErr := APIError{APIErrorBody: errBody, StatusCode: resp.StatusCode}
+			if policy.ShouldRetry(resp.StatusCode, errBody.ErrorCode) && attempt < policy.MaxAttempts()-1 {
+				lastErr = apiErr
+				continue
+			}
+			return apiErr
+		}
+
+		if response != nil && len(respBody) > 0 {
+			return json.Unmarshal(respBody, response)
+		}
+		return nil
+	}
+	return lastErr
+}
+
+// Get issues an authenticated GET request against path and unmarshals the JSON response into response.
+func (c *DatabricksClient) Get(path string, response interface{}) error {
+	return c.authenticatedRequest(context.Background(), http.MethodGet, path, nil, response)
+}
+
+// Post issues an authenticated POST request against path with body marshaled as JSON,
+// and unmarshals the JSON response into response.
+func (c *DatabricksClient) Post(path string, body interface{}, response interface{}) error {
+	return c.authenticatedRequest(context.Background(), http.MethodPost, path, body, response)
+}
+
+// Delete issues an authenticated DELETE request against path.
+func (c *DatabricksClient) Delete(path string, body interface{}) error {
+	return c.authenticatedRequest(context.Background(), http.MethodDelete, path, body, nil)
+}
+
+// GetContext is Get, but the request is bound to ctx and aborts (returning an *ErrCanceled)
+// if ctx is canceled or its deadline elapses before the response arrives.
+func (c *DatabricksClient) GetContext(ctx context.Context, path string, response interface{}) error {
+	return c.authenticatedRequest(ctx, http.MethodGet, path, nil, response)
+}
+
+// PostContext is Post, bound to ctx. See GetContext.
+func (c *DatabricksClient) PostContext(ctx context.Context, path string, body interface{}, response interface{}) error {
+	return c.authenticatedRequest(ctx, http.MethodPost, path, body, response)
+}
+
+// DeleteContext is Delete, bound to ctx. See GetContext.
+func (c *DatabricksClient) DeleteContext(ctx context.Context, path string, body interface{}) error {
+	return c.authenticatedRequest(ctx, http.MethodDelete, path, body, nil)
+}
+
+// IsNotFound reports whether err is a Databricks NOT_FOUND API error, the standard signal
+// that a resource has disappeared out from under Terraform state.
+func IsNotFound(err error) bool {
+	apiErr, ok := err.(APIError)
+	return ok && apiErr.ErrorCode == "NOT_FOUND"
+}
@@ -0,0 +1,72 @@
+package common
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy decides whether a failed request should be retried and how long to wait
+// before the next attempt, letting callers swap in stricter or looser behavior than the
+// DefaultRetryPolicy without touching DatabricksClient itself.
+type RetryPolicy interface {
+	// MaxAttempts is the total number of times a request may be tried, including the
+	// first attempt.
+	MaxAttempts() int
+	// ShouldRetry reports whether a failure is transient and worth retrying.
+	ShouldRetry(statusCode int, errorCode string) bool
+	// Backoff returns how long to wait before the given attempt (0-indexed, so
+	// Backoff(0) is the delay before the first retry).
+	Backoff(attempt int) time.Duration
+}
+
+// retryableStatusCodes are the HTTP statuses the Databricks API is known to return for
+// transient conditions rather than a permanent failure of the request.
+var retryableStatusCodes = map[int]bool{
+	408: true,
+	429: true,
+	500: true,
+	502: true,
+	503: true,
+	504: true,
+}
+
+// DefaultRetryPolicy retries transient failures - request timeouts, rate limiting, upstream
+// 5xx errors, and a cluster that isn't ready yet - with exponential backoff and full jitter,
+// so a fleet of clients hitting the same failure don't retry in lockstep.
+type DefaultRetryPolicy struct {
+	Attempts  int
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// MaxAttempts implements RetryPolicy.
+func (p DefaultRetryPolicy) MaxAttempts() int {
+	if p.Attempts <= 0 {
+		return 3
+	}
+	return p.Attempts
+}
+
+// ShouldRetry implements RetryPolicy.
+func (p DefaultRetryPolicy) ShouldRetry(statusCode int, errorCode string) bool {
+	return retryableStatusCodes[statusCode] || errorCode == "NOT_READY"
+}
+
+// Backoff implements RetryPolicy using exponential backoff with full jitter: the delay is
+// chosen uniformly at random between 0 and min(MaxDelay, BaseDelay*2^attempt).
+func (p DefaultRetryPolicy) Backoff(attempt int) time.Duration {
+	base := p.BaseDelay
+	if base <= 0 {
+		base = 500 * time.Millisecond
+	}
+	max := p.MaxDelay
+	if max <= 0 {
+		max = 30 * time.Second
+	}
+	ceiling := time.Duration(float64(base) * math.Pow(2, float64(attempt)))
+	if ceiling <= 0 || ceiling > max {
+		ceiling = max
+	}
+	return time.Duration(rand.Int63n(int64(ceiling) + 1))
+}
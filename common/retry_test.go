@@ -0,0 +1,46 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultRetryPolicy_MaxAttempts(t *testing.T) {
+	assert.Equal(t, 3, DefaultRetryPolicy{}.MaxAttempts())
+	assert.Equal(t, 5, DefaultRetryPolicy{Attempts: 5}.MaxAttempts())
+}
+
+func TestDefaultRetryPolicy_ShouldRetry(t *testing.T) {
+	cases := []struct {
+		name       string
+		statusCode int
+		errorCode  string
+		want       bool
+	}{
+		{"too many requests", 429, "", true},
+		{"request timeout", 408, "", true},
+		{"bad gateway", 502, "", true},
+		{"service unavailable", 503, "", true},
+		{"gateway timeout", 504, "", true},
+		{"internal server error", 500, "", true},
+		{"not ready", 400, "NOT_READY", true},
+		{"not found is permanent", 404, "NOT_FOUND", false},
+		{"bad request is permanent", 400, "INVALID_PARAMETER_VALUE", false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.want, DefaultRetryPolicy{}.ShouldRetry(c.statusCode, c.errorCode))
+		})
+	}
+}
+
+func TestDefaultRetryPolicy_Backoff(t *testing.T) {
+	policy := DefaultRetryPolicy{BaseDelay: 10 * time.Millisecond, MaxDelay: 100 * time.Millisecond}
+	for attempt := 0; attempt < 10; attempt++ {
+		d := policy.Backoff(attempt)
+		assert.True(t, d >= 0, "backoff must not be negative")
+		assert.True(t, d <= 100*time.Millisecond, "backoff must respect MaxDelay")
+	}
+}
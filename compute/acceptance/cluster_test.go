@@ -79,6 +79,63 @@ func testDefaultClusterResource(instancePool, awsAttributes string) string {
 	}`, instancePool, awsAttributes)
 }
 
+func testClusterResourceWithInitScripts(destinations []string) string {
+	var scripts bytes.Buffer
+	for _, dest := range destinations {
+		scripts.WriteString(fmt.Sprintf(`
+		init_scripts {
+			dbfs {
+				destination = "%s"
+			}
+		}`, dest))
+	}
+	return fmt.Sprintf(`
+	resource "databricks_cluster" "test_cluster" {
+		cluster_name = "test-cluster-init-script-order"
+		spark_version = "6.6.x-scala2.11"
+		autoscale {
+		min_workers = 1
+		max_workers = 2
+		}
+		autotermination_minutes = 10
+		%s
+	}`, scripts.String())
+}
+
+func testDefaultClusterResourceWithTimeout(instancePool, awsAttributes, createTimeout string) string {
+	return fmt.Sprintf(`
+	resource "databricks_cluster" "test_cluster" {
+		cluster_name = "test-cluster-browser-timeout"
+		%s
+		spark_version = "6.6.x-scala2.11"
+		autoscale {
+		min_workers = 1
+		max_workers = 2
+		}
+		%s
+		autotermination_minutes = 10
+		timeouts {
+			create = "%s"
+		}
+	}`, instancePool, awsAttributes, createTimeout)
+}
+
+func testDefaultClusterResourceSkipDestroy(instancePool, awsAttributes string) string {
+	return fmt.Sprintf(`
+	resource "databricks_cluster" "test_cluster" {
+		cluster_name = "test-cluster-browser-skip-destroy"
+		%s
+		spark_version = "6.6.x-scala2.11"
+		autoscale {
+		min_workers = 1
+		max_workers = 2
+		}
+		%s
+		autotermination_minutes = 10
+		skip_destroy = true
+	}`, instancePool, awsAttributes)
+}
+
 func TestAwsAccClusterResource_ValidatePlan(t *testing.T) {
 	// TODO: refactor for common instance pool & AZ CLI
 	awsAttrNoZoneID := map[string]string{}
@@ -148,6 +205,26 @@ func TestAwsAccClusterResource_CreateClusterViaInstancePool(t *testing.T) {
 					testClusterExistsAndTerminateForFutureTests("databricks_cluster.test_cluster", &clusterInfo, t),
 				),
 			},
+			{
+				ResourceName:            "databricks_cluster.test_cluster",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts.%", "timeouts.create"},
+				Config:                  resourceConfig,
+			},
+			{
+				ResourceName:      "databricks_instance_pool.my_pool",
+				ImportState:       true,
+				ImportStateVerify: true,
+				Config:            resourceConfig,
+			},
+			{
+				ResourceName:            "databricks_instance_profile.my_instance_profile",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_validation"},
+				Config:                  resourceConfig,
+			},
 			{
 				Config: resourceInstanceProfileConfig,
 				Check: resource.ComposeTestCheckFunc(
@@ -171,6 +248,127 @@ func TestAwsAccClusterResource_CreateClusterViaInstancePool(t *testing.T) {
 	})
 }
 
+func TestAwsAccClusterResource_CreateClusterViaInstancePool_SkipDestroy(t *testing.T) {
+	awsAttrInstancePool := map[string]string{
+		"zone_id":      "${data.databricks_zones.default_zones.default_zone}",
+		"availability": "SPOT",
+	}
+	randomInstancePoolName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	var clusterInfo ClusterInfo
+	instancePoolLine := testGetClusterInstancePoolConfig("${databricks_instance_pool.my_pool.id}")
+	resourceConfig := testDefaultZones() +
+		testDefaultAwsInstancePoolResource(testGetAwsAttributes(awsAttrInstancePool), randomInstancePoolName) +
+		testDefaultClusterResourceSkipDestroy(instancePoolLine, "")
+
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testClusterExists("databricks_cluster.test_cluster", &clusterInfo, t),
+				),
+			},
+			{
+				Config:  resourceConfig,
+				Destroy: true,
+				Check: resource.ComposeTestCheckFunc(
+					testClusterStillExistsAfterSkipDestroy(&clusterInfo, t),
+				),
+			},
+		},
+	})
+}
+
+// TestAwsAccClusterResource_CreateClusterCustomTimeout exercises a `timeouts { create = ... }`
+// block that's longer than the provider default, for workspaces on constrained cloud quotas
+// where cluster START can exceed the silent default and would otherwise surface a confusing
+// "did not reach RUNNING in time" error instead of the user's own budget.
+func TestAwsAccClusterResource_CreateClusterCustomTimeout(t *testing.T) {
+	var clusterInfo ClusterInfo
+	resourceConfig := testDefaultZones() +
+		testDefaultClusterResourceWithTimeout("", "", "45m")
+
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testClusterExistsAndTerminateForFutureTests("databricks_cluster.test_cluster", &clusterInfo, t),
+				),
+			},
+		},
+	})
+}
+
+// TestAwsAccClusterResource_InitScriptOrdering verifies that init_scripts round-trips through
+// the Clusters API in the order the user configured them, which matters for Spark users who
+// layer JDBC drivers on top of security configuration.
+func TestAwsAccClusterResource_InitScriptOrdering(t *testing.T) {
+	wantOrder := []string{
+		"dbfs:/init-scripts/security-config.sh",
+		"dbfs:/init-scripts/jdbc-driver.sh",
+	}
+	var clusterInfo ClusterInfo
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testClusterResourceWithInitScripts(wantOrder),
+				Check: resource.ComposeTestCheckFunc(
+					testClusterExists("databricks_cluster.test_cluster", &clusterInfo, t),
+					testAccCheck_bootstrap_order("databricks_cluster.test_cluster", &clusterInfo, wantOrder),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheck_bootstrap_order asserts the live cluster's init_scripts sequence matches the
+// order the caller configured, rather than merely the same set of scripts.
+func testAccCheck_bootstrap_order(n string, cluster *ClusterInfo, wantOrder []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(cluster.InitScripts) != len(wantOrder) {
+			return fmt.Errorf("expected %d init scripts, cluster %s has %d", len(wantOrder), cluster.ClusterID, len(cluster.InitScripts))
+		}
+		for i, want := range wantOrder {
+			got := cluster.InitScripts[i]
+			if got.Dbfs == nil || got.Dbfs.Destination != want {
+				return fmt.Errorf("init_scripts[%d]: expected %q, got %+v", i, want, got)
+			}
+		}
+		return nil
+	}
+}
+
+func testClusterExists(n string, cluster *ClusterInfo, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		conn := common.CommonEnvironmentClient()
+		resp, err := NewClustersAPI(conn).Get(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		*cluster = resp
+		return nil
+	}
+}
+
+// testClusterStillExistsAfterSkipDestroy confirms that removing a skip_destroy=true
+// cluster from Terraform state left the underlying cluster in the workspace,
+// then terminates it directly so it doesn't linger after the test suite finishes.
+func testClusterStillExistsAfterSkipDestroy(cluster *ClusterInfo, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := common.CommonEnvironmentClient()
+		resp, err := NewClustersAPI(conn).Get(cluster.ClusterID)
+		if err != nil {
+			return fmt.Errorf("skip_destroy cluster should still exist after Terraform destroy: %w", err)
+		}
+		return NewClustersAPI(conn).Terminate(resp.ClusterID)
+	}
+}
+
 func testClusterExistsAndTerminateForFutureTests(n string, cluster *ClusterInfo, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// find the corresponding state object
@@ -0,0 +1,154 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/databrickslabs/databricks-terraform/common"
+)
+
+// AwsAttributes mirrors the `aws_attributes` block accepted by the Clusters API when a
+// cluster or instance pool is provisioned on AWS.
+type AwsAttributes struct {
+	FirstOnDemand       int32  `json:"first_on_demand,omitempty"`
+	Availability        string `json:"availability,omitempty"`
+	ZoneID              string `json:"zone_id,omitempty"`
+	InstanceProfileArn  string `json:"instance_profile_arn,omitempty"`
+	SpotBidPricePercent int32  `json:"spot_bid_price_percent,omitempty"`
+	EbsVolumeType       string `json:"ebs_volume_type,omitempty"`
+}
+
+// AutoScale mirrors the `autoscale` block that lets a cluster grow/shrink between
+// min_workers and max_workers based on load.
+type AutoScale struct {
+	MinWorkers int32 `json:"min_workers,omitempty"`
+	MaxWorkers int32 `json:"max_workers,omitempty"`
+}
+
+// Cluster is the request/response shape shared by cluster create/edit/get calls.
+type Cluster struct {
+	ClusterID              string            `json:"cluster_id,omitempty"`
+	ClusterName            string            `json:"cluster_name,omitempty"`
+	SparkVersion           string            `json:"spark_version"`
+	NumWorkers             int32             `json:"num_workers,omitempty"`
+	AutoScale              *AutoScale        `json:"autoscale,omitempty"`
+	InstancePoolID         string            `json:"instance_pool_id,omitempty"`
+	NodeTypeID             string            `json:"node_type_id,omitempty"`
+	AwsAttributes          *AwsAttributes    `json:"aws_attributes,omitempty"`
+	AutoterminationMinutes int32             `json:"autotermination_minutes,omitempty"`
+	SparkConf              map[string]string `json:"spark_conf,omitempty"`
+	SparkEnvVars           map[string]string `json:"spark_env_vars,omitempty"`
+	CustomTags             map[string]string `json:"custom_tags,omitempty"`
+	InitScripts            []InitScriptInfo  `json:"init_scripts,omitempty"`
+}
+
+// InitScriptInfo points to a cluster-scoped init script, e.g. one stored on DBFS.
+type InitScriptInfo struct {
+	Dbfs *DbfsStorageInfo `json:"dbfs,omitempty"`
+}
+
+// DbfsStorageInfo is a DBFS path used as the location for logs or init scripts.
+type DbfsStorageInfo struct {
+	Destination string `json:"destination"`
+}
+
+// ClusterInfo is what the Databricks API returns for GET /clusters/get.
+type ClusterInfo struct {
+	Cluster
+	State        string `json:"state,omitempty"`
+	StateMessage string `json:"state_message,omitempty"`
+}
+
+// ClustersAPI exposes the `/api/2.0/clusters` family of endpoints.
+type ClustersAPI struct {
+	client *common.DatabricksClient
+}
+
+// NewClustersAPI creates a ClustersAPI instance from a common.DatabricksClient.
+func NewClustersAPI(client *common.DatabricksClient) ClustersAPI {
+	return ClustersAPI{client: client}
+}
+
+// defaultClusterPollTimeout is used when Create or Start is called with a non-positive
+// timeout. databricks_cluster always passes its own Timeouts values instead.
+const defaultClusterPollTimeout = 20 * time.Minute
+
+// Create submits a cluster creation request and waits up to timeout for it to reach the
+// RUNNING state.
+func (a ClustersAPI) Create(cluster Cluster, timeout time.Duration) (info ClusterInfo, err error) {
+	err = a.client.Post("/api/2.0/clusters/create", cluster, &info)
+	if err != nil {
+		return
+	}
+	return a.waitForClusterRunning(info.ClusterID, timeout)
+}
+
+// Edit updates an existing cluster's configuration.
+func (a ClustersAPI) Edit(cluster Cluster) error {
+	return a.client.Post("/api/2.0/clusters/edit", cluster, nil)
+}
+
+// Get retrieves the current state of a cluster by ID.
+func (a ClustersAPI) Get(clusterID string) (info ClusterInfo, err error) {
+	err = a.client.Get(fmt.Sprintf("/api/2.0/clusters/get?cluster_id=%s", clusterID), &info)
+	return
+}
+
+// Start starts a terminated cluster and waits up to timeout for it to reach RUNNING.
+func (a ClustersAPI) Start(clusterID string, timeout time.Duration) error {
+	err := a.client.Post("/api/2.0/clusters/start", map[string]string{"cluster_id": clusterID}, nil)
+	if err != nil {
+		return err
+	}
+	_, err = a.waitForClusterRunning(clusterID, timeout)
+	return err
+}
+
+// Terminate stops a running cluster but leaves it in place so it can be restarted later.
+func (a ClustersAPI) Terminate(clusterID string) error {
+	return a.client.Post("/api/2.0/clusters/delete", map[string]string{"cluster_id": clusterID}, nil)
+}
+
+// PermanentDelete permanently removes a cluster, including its event log and metadata, and
+// waits up to timeout for it to disappear from the workspace. Unlike Terminate, this cannot
+// be undone by starting the cluster again.
+func (a ClustersAPI) PermanentDelete(clusterID string, timeout time.Duration) error {
+	if err := a.client.Post("/api/2.0/clusters/permanent-delete", map[string]string{"cluster_id": clusterID}, nil); err != nil {
+		return err
+	}
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		_, err := a.Get(clusterID)
+		if common.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		time.Sleep(5 * time.Second)
+	}
+	return fmt.Errorf("cluster %s was not removed within %s", clusterID, timeout)
+}
+
+func (a ClustersAPI) waitForClusterRunning(clusterID string, timeout time.Duration) (result ClusterInfo, err error) {
+	if timeout <= 0 {
+		timeout = defaultClusterPollTimeout
+	}
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		info, err := a.Get(clusterID)
+		if err != nil {
+			return info, err
+		}
+		switch info.State {
+		case "RUNNING":
+			return info, nil
+		case "ERROR", "TERMINATED":
+			return info, fmt.Errorf("cluster %s did not start: %s", clusterID, info.StateMessage)
+		}
+		log.Printf("[DEBUG] waiting for cluster %s to start, currently %s", clusterID, info.State)
+		time.Sleep(10 * time.Second)
+	}
+	return result, fmt.Errorf("cluster %s did not reach RUNNING within %s", clusterID, timeout)
+}
@@ -0,0 +1,36 @@
+package compute
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// suppressEquivalentJsonDiffs is the compute-package analog of terraform-provider-aws's
+// helper of the same name: the Databricks API normalizes values it round-trips through
+// spark_conf/custom_tags-shaped maps (whitespace, key ordering, bool/string coercion), so a
+// byte-for-byte comparison of old vs. new would show perpetual drift. When both values
+// parse as JSON we compare them structurally; otherwise we fall back to a case-preserving
+// string comparison.
+func suppressEquivalentJsonDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+	var oldJSON, newJSON interface{}
+	if json.Unmarshal([]byte(old), &oldJSON) != nil {
+		return false
+	}
+	if json.Unmarshal([]byte(new), &newJSON) != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldJSON, newJSON)
+}
+
+// suppressCaseInsensitiveDiff normalizes values that the API is known to canonicalize the
+// case of (e.g. AWS availability/ACL enums coming back upper-cased) without masking a
+// genuine change in content.
+func suppressCaseInsensitiveDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
@@ -0,0 +1,33 @@
+package compute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuppressEquivalentJsonDiffs(t *testing.T) {
+	cases := []struct {
+		name string
+		old  string
+		new  string
+		want bool
+	}{
+		{"identical", `{"a":"b"}`, `{"a":"b"}`, true},
+		{"key order", `{"a":"b","c":"d"}`, `{"c":"d","a":"b"}`, true},
+		{"whitespace", `{"a": "b"}`, `{"a":"b"}`, true},
+		{"different values", `{"a":"b"}`, `{"a":"c"}`, false},
+		{"not json falls back to string equality", "BucketOwnerFullControl", "BucketOwnerFullControl", true},
+		{"not json, different strings", "BucketOwnerFullControl", "Private", false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.want, suppressEquivalentJsonDiffs("spark_conf.some.key", c.old, c.new, nil))
+		})
+	}
+}
+
+func TestSuppressCaseInsensitiveDiff(t *testing.T) {
+	assert.True(t, suppressCaseInsensitiveDiff("aws_attributes.0.availability", "SPOT", "spot", nil))
+	assert.False(t, suppressCaseInsensitiveDiff("aws_attributes.0.availability", "SPOT", "ON_DEMAND", nil))
+}
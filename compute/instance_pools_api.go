@@ -0,0 +1,80 @@
+package compute
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/databrickslabs/databricks-terraform/common"
+)
+
+// DiskSpec configures the EBS volumes attached to instances in a pool.
+type DiskSpec struct {
+	DiskType  *DiskType `json:"disk_type,omitempty"`
+	DiskSize  int32     `json:"disk_size,omitempty"`
+	DiskCount int32     `json:"disk_count,omitempty"`
+}
+
+// DiskType is the cloud-specific disk family, e.g. EBS volume type on AWS.
+type DiskType struct {
+	EbsVolumeType string `json:"ebs_volume_type,omitempty"`
+}
+
+// InstancePool is the request/response shape for the Instance Pools API.
+type InstancePool struct {
+	InstancePoolID                     string         `json:"instance_pool_id,omitempty"`
+	InstancePoolName                   string         `json:"instance_pool_name"`
+	MinIdleInstances                   int32          `json:"min_idle_instances,omitempty"`
+	MaxCapacity                        int32          `json:"max_capacity,omitempty"`
+	NodeTypeID                         string         `json:"node_type_id"`
+	AwsAttributes                      *AwsAttributes `json:"aws_attributes,omitempty"`
+	IdleInstanceAutoterminationMinutes int32          `json:"idle_instance_autotermination_minutes"`
+	DiskSpec                           *DiskSpec      `json:"disk_spec,omitempty"`
+	CustomTags                         map[string]string `json:"custom_tags,omitempty"`
+}
+
+// InstancePoolsAPI exposes the `/api/2.0/instance-pools` family of endpoints.
+type InstancePoolsAPI struct {
+	client *common.DatabricksClient
+}
+
+// NewInstancePoolsAPI creates an InstancePoolsAPI instance from a common.DatabricksClient.
+func NewInstancePoolsAPI(client *common.DatabricksClient) InstancePoolsAPI {
+	return InstancePoolsAPI{client: client}
+}
+
+// Create provisions a new instance pool.
+func (a InstancePoolsAPI) Create(pool InstancePool) (info InstancePool, err error) {
+	err = a.client.Post("/api/2.0/instance-pools/create", pool, &info)
+	return
+}
+
+// Edit updates an existing instance pool's configuration.
+func (a InstancePoolsAPI) Edit(pool InstancePool) error {
+	return a.client.Post("/api/2.0/instance-pools/edit", pool, nil)
+}
+
+// Get retrieves the current configuration of an instance pool by ID.
+func (a InstancePoolsAPI) Get(instancePoolID string) (info InstancePool, err error) {
+	err = a.client.Get(fmt.Sprintf("/api/2.0/instance-pools/get?instance_pool_id=%s", instancePoolID), &info)
+	return
+}
+
+// Delete permanently deletes an instance pool and waits up to timeout for it to disappear
+// from the workspace, since idle instances can take a few moments to be reclaimed.
+func (a InstancePoolsAPI) Delete(instancePoolID string, timeout time.Duration) error {
+	if err := a.client.Post("/api/2.0/instance-pools/delete", map[string]string{"instance_pool_id": instancePoolID}, nil); err != nil {
+		return err
+	}
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		_, err := a.Get(instancePoolID)
+		if common.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		time.Sleep(5 * time.Second)
+	}
+	return fmt.Errorf("instance pool %s was not removed within %s", instancePoolID, timeout)
+}
@@ -0,0 +1,42 @@
+package compute
+
+import "github.com/databrickslabs/databricks-terraform/common"
+
+// InstanceProfileInfo is the request/response shape for the Instance Profiles API.
+type InstanceProfileInfo struct {
+	InstanceProfileArn string `json:"instance_profile_arn"`
+	IsMetaInstanceProfile bool `json:"is_meta_instance_profile,omitempty"`
+}
+
+// InstanceProfilesAPI exposes the `/api/2.0/instance-profiles` family of endpoints.
+type InstanceProfilesAPI struct {
+	client *common.DatabricksClient
+}
+
+// NewInstanceProfilesAPI creates an InstanceProfilesAPI instance from a common.DatabricksClient.
+func NewInstanceProfilesAPI(client *common.DatabricksClient) InstanceProfilesAPI {
+	return InstanceProfilesAPI{client: client}
+}
+
+// Add registers an instance profile ARN with the workspace, optionally skipping the
+// AWS-side validation that the profile can actually be assumed.
+func (a InstanceProfilesAPI) Add(instanceProfileArn string, skipValidation bool) error {
+	return a.client.Post("/api/2.0/instance-profiles/add", map[string]interface{}{
+		"instance_profile_arn": instanceProfileArn,
+		"skip_validation":      skipValidation,
+	}, nil)
+}
+
+// List returns every instance profile currently registered with the workspace.
+func (a InstanceProfilesAPI) List() (profiles []InstanceProfileInfo, err error) {
+	var resp struct {
+		InstanceProfiles []InstanceProfileInfo `json:"instance_profiles"`
+	}
+	err = a.client.Get("/api/2.0/instance-profiles/list", &resp)
+	return resp.InstanceProfiles, err
+}
+
+// Remove unregisters an instance profile ARN from the workspace.
+func (a InstanceProfilesAPI) Remove(instanceProfileArn string) error {
+	return a.client.Post("/api/2.0/instance-profiles/remove", map[string]string{"instance_profile_arn": instanceProfileArn}, nil)
+}
@@ -0,0 +1,327 @@
+package compute
+
+import (
+	"log"
+	"time"
+
+	"github.com/databrickslabs/databricks-terraform/common"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func clusterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cluster_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"spark_version": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"num_workers": {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ConflictsWith: []string{"autoscale"},
+		},
+		"autoscale": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"min_workers": {
+						Type:     schema.TypeInt,
+						Required: true,
+					},
+					"max_workers": {
+						Type:     schema.TypeInt,
+						Required: true,
+					},
+				},
+			},
+		},
+		"instance_pool_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"node_type_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"aws_attributes": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"availability":         {Type: schema.TypeString, Optional: true, DiffSuppressFunc: suppressCaseInsensitiveDiff},
+					"zone_id":              {Type: schema.TypeString, Optional: true},
+					"instance_profile_arn": {Type: schema.TypeString, Optional: true},
+					"spot_bid_price_percent": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"ebs_volume_type": {Type: schema.TypeString, Optional: true, DiffSuppressFunc: suppressCaseInsensitiveDiff},
+					"first_on_demand": {Type: schema.TypeInt, Optional: true},
+				},
+			},
+		},
+		"autotermination_minutes": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  60,
+		},
+		"spark_conf": {
+			Type:             schema.TypeMap,
+			Optional:         true,
+			Elem:             &schema.Schema{Type: schema.TypeString},
+			DiffSuppressFunc: suppressEquivalentJsonDiffs,
+		},
+		"spark_env_vars": {
+			Type:             schema.TypeMap,
+			Optional:         true,
+			Elem:             &schema.Schema{Type: schema.TypeString},
+			DiffSuppressFunc: suppressEquivalentJsonDiffs,
+		},
+		"custom_tags": {
+			Type:             schema.TypeMap,
+			Optional:         true,
+			Elem:             &schema.Schema{Type: schema.TypeString},
+			DiffSuppressFunc: suppressEquivalentJsonDiffs,
+		},
+		"init_scripts": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"dbfs": {
+						Type:     schema.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"destination": {Type: schema.TypeString, Required: true},
+							},
+						},
+					},
+				},
+			},
+		},
+		// skip_destroy leaves the upstream cluster running in the workspace when the
+		// resource is removed from state, for long-lived shared clusters that must
+		// outlive any one Terraform workspace.
+		"skip_destroy": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func expandAwsAttributes(raw interface{}) *AwsAttributes {
+	list := raw.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	m := list[0].(map[string]interface{})
+	return &AwsAttributes{
+		Availability:        m["availability"].(string),
+		ZoneID:              m["zone_id"].(string),
+		InstanceProfileArn:  m["instance_profile_arn"].(string),
+		SpotBidPricePercent: int32(m["spot_bid_price_percent"].(int)),
+		EbsVolumeType:       m["ebs_volume_type"].(string),
+		FirstOnDemand:       int32(m["first_on_demand"].(int)),
+	}
+}
+
+func flattenAwsAttributes(a *AwsAttributes) []interface{} {
+	if a == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"availability":           a.Availability,
+		"zone_id":                a.ZoneID,
+		"instance_profile_arn":   a.InstanceProfileArn,
+		"spot_bid_price_percent": int(a.SpotBidPricePercent),
+		"ebs_volume_type":        a.EbsVolumeType,
+		"first_on_demand":        int(a.FirstOnDemand),
+	}}
+}
+
+func clusterFromData(d *schema.ResourceData) Cluster {
+	cluster := Cluster{
+		ClusterID:              d.Id(),
+		ClusterName:            d.Get("cluster_name").(string),
+		SparkVersion:           d.Get("spark_version").(string),
+		NumWorkers:             int32(d.Get("num_workers").(int)),
+		InstancePoolID:         d.Get("instance_pool_id").(string),
+		NodeTypeID:             d.Get("node_type_id").(string),
+		AutoterminationMinutes: int32(d.Get("autotermination_minutes").(int)),
+		SparkConf:              readStringMap(d, "spark_conf"),
+		SparkEnvVars:           readStringMap(d, "spark_env_vars"),
+		CustomTags:             readStringMap(d, "custom_tags"),
+		AwsAttributes:          expandAwsAttributes(d.Get("aws_attributes")),
+		InitScripts:            expandInitScripts(d.Get("init_scripts")),
+	}
+	if raw, ok := d.GetOk("autoscale"); ok {
+		list := raw.([]interface{})
+		if len(list) > 0 {
+			m := list[0].(map[string]interface{})
+			cluster.AutoScale = &AutoScale{
+				MinWorkers: int32(m["min_workers"].(int)),
+				MaxWorkers: int32(m["max_workers"].(int)),
+			}
+		}
+	}
+	return cluster
+}
+
+func flattenInitScripts(scripts []InitScriptInfo) []interface{} {
+	if len(scripts) == 0 {
+		return nil
+	}
+	out := make([]interface{}, 0, len(scripts))
+	for _, s := range scripts {
+		if s.Dbfs == nil {
+			continue
+		}
+		out = append(out, map[string]interface{}{
+			"dbfs": []interface{}{map[string]interface{}{
+				"destination": s.Dbfs.Destination,
+			}},
+		})
+	}
+	return out
+}
+
+func expandInitScripts(raw interface{}) []InitScriptInfo {
+	list := raw.([]interface{})
+	if len(list) == 0 {
+		return nil
+	}
+	out := make([]InitScriptInfo, 0, len(list))
+	for _, item := range list {
+		m := item.(map[string]interface{})
+		dbfsList := m["dbfs"].([]interface{})
+		if len(dbfsList) == 0 {
+			continue
+		}
+		dbfs := dbfsList[0].(map[string]interface{})
+		out = append(out, InitScriptInfo{
+			Dbfs: &DbfsStorageInfo{Destination: dbfs["destination"].(string)},
+		})
+	}
+	return out
+}
+
+func readStringMap(d *schema.ResourceData, key string) map[string]string {
+	raw := d.Get(key).(map[string]interface{})
+	if len(raw) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(raw))
+	for k, v := range raw {
+		out[k] = v.(string)
+	}
+	return out
+}
+
+func updateClusterData(d *schema.ResourceData, info ClusterInfo) error {
+	d.SetId(info.ClusterID)
+	if err := d.Set("cluster_name", info.ClusterName); err != nil {
+		return err
+	}
+	if err := d.Set("spark_version", info.SparkVersion); err != nil {
+		return err
+	}
+	if err := d.Set("num_workers", info.NumWorkers); err != nil {
+		return err
+	}
+	if err := d.Set("instance_pool_id", info.InstancePoolID); err != nil {
+		return err
+	}
+	if err := d.Set("node_type_id", info.NodeTypeID); err != nil {
+		return err
+	}
+	if err := d.Set("autotermination_minutes", info.AutoterminationMinutes); err != nil {
+		return err
+	}
+	if err := d.Set("spark_conf", info.SparkConf); err != nil {
+		return err
+	}
+	if err := d.Set("spark_env_vars", info.SparkEnvVars); err != nil {
+		return err
+	}
+	if err := d.Set("custom_tags", info.CustomTags); err != nil {
+		return err
+	}
+	if err := d.Set("aws_attributes", flattenAwsAttributes(info.AwsAttributes)); err != nil {
+		return err
+	}
+	if err := d.Set("init_scripts", flattenInitScripts(info.InitScripts)); err != nil {
+		return err
+	}
+	if info.AutoScale != nil {
+		return d.Set("autoscale", []interface{}{map[string]interface{}{
+			"min_workers": int(info.AutoScale.MinWorkers),
+			"max_workers": int(info.AutoScale.MaxWorkers),
+		}})
+	}
+	return nil
+}
+
+// ResourceCluster defines the `databricks_cluster` resource, backed by the Clusters API.
+func ResourceCluster() *schema.Resource {
+	return &schema.Resource{
+		Schema: clusterSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			info, err := NewClustersAPI(client).Create(clusterFromData(d), d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return err
+			}
+			return updateClusterData(d, info)
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			info, err := NewClustersAPI(client).Get(d.Id())
+			if err != nil {
+				if common.IsNotFound(err) {
+					d.SetId("")
+					return nil
+				}
+				return err
+			}
+			return updateClusterData(d, info)
+		},
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			cluster := clusterFromData(d)
+			if err := NewClustersAPI(client).Edit(cluster); err != nil {
+				return err
+			}
+			info, err := NewClustersAPI(client).Get(d.Id())
+			if err != nil {
+				return err
+			}
+			return updateClusterData(d, info)
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			if d.Get("skip_destroy").(bool) {
+				log.Printf("[DEBUG] skip_destroy is set on cluster %s, removing from state without deleting", d.Id())
+				return nil
+			}
+			client := m.(*common.DatabricksClient)
+			return NewClustersAPI(client).PermanentDelete(d.Id(), d.Timeout(schema.TimeoutDelete))
+		},
+	}
+}
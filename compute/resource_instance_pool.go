@@ -0,0 +1,192 @@
+package compute
+
+import (
+	"log"
+	"time"
+
+	"github.com/databrickslabs/databricks-terraform/common"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func instancePoolFromData(d *schema.ResourceData) InstancePool {
+	pool := InstancePool{
+		InstancePoolID:                     d.Id(),
+		InstancePoolName:                   d.Get("instance_pool_name").(string),
+		MinIdleInstances:                   int32(d.Get("min_idle_instances").(int)),
+		MaxCapacity:                        int32(d.Get("max_capacity").(int)),
+		NodeTypeID:                         d.Get("node_type_id").(string),
+		IdleInstanceAutoterminationMinutes: int32(d.Get("idle_instance_autotermination_minutes").(int)),
+		AwsAttributes:                      expandAwsAttributes(d.Get("aws_attributes")),
+		CustomTags:                         readStringMap(d, "custom_tags"),
+	}
+	if raw, ok := d.GetOk("disk_spec"); ok {
+		list := raw.([]interface{})
+		if len(list) > 0 {
+			m := list[0].(map[string]interface{})
+			pool.DiskSpec = &DiskSpec{
+				DiskType:  &DiskType{EbsVolumeType: m["ebs_volume_type"].(string)},
+				DiskSize:  int32(m["disk_size"].(int)),
+				DiskCount: int32(m["disk_count"].(int)),
+			}
+		}
+	}
+	return pool
+}
+
+func updateInstancePoolData(d *schema.ResourceData, info InstancePool) error {
+	d.SetId(info.InstancePoolID)
+	if err := d.Set("instance_pool_name", info.InstancePoolName); err != nil {
+		return err
+	}
+	if err := d.Set("min_idle_instances", info.MinIdleInstances); err != nil {
+		return err
+	}
+	if err := d.Set("max_capacity", info.MaxCapacity); err != nil {
+		return err
+	}
+	if err := d.Set("node_type_id", info.NodeTypeID); err != nil {
+		return err
+	}
+	if err := d.Set("idle_instance_autotermination_minutes", info.IdleInstanceAutoterminationMinutes); err != nil {
+		return err
+	}
+	if err := d.Set("aws_attributes", flattenAwsAttributes(info.AwsAttributes)); err != nil {
+		return err
+	}
+	if err := d.Set("custom_tags", info.CustomTags); err != nil {
+		return err
+	}
+	if info.DiskSpec != nil {
+		ebsVolumeType := ""
+		if info.DiskSpec.DiskType != nil {
+			ebsVolumeType = info.DiskSpec.DiskType.EbsVolumeType
+		}
+		return d.Set("disk_spec", []interface{}{map[string]interface{}{
+			"ebs_volume_type": ebsVolumeType,
+			"disk_size":       int(info.DiskSpec.DiskSize),
+			"disk_count":      int(info.DiskSpec.DiskCount),
+		}})
+	}
+	return nil
+}
+
+// ResourceInstancePool defines the `databricks_instance_pool` resource, backed by the
+// Instance Pools API.
+func ResourceInstancePool() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_pool_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"min_idle_instances": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"max_capacity": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"node_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"idle_instance_autotermination_minutes": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"aws_attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability":         {Type: schema.TypeString, Optional: true, DiffSuppressFunc: suppressCaseInsensitiveDiff},
+						"zone_id":              {Type: schema.TypeString, Optional: true},
+						"instance_profile_arn": {Type: schema.TypeString, Optional: true},
+						"spot_bid_price_percent": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"ebs_volume_type": {Type: schema.TypeString, Optional: true, DiffSuppressFunc: suppressCaseInsensitiveDiff},
+						"first_on_demand": {Type: schema.TypeInt, Optional: true},
+					},
+				},
+			},
+			"custom_tags": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"disk_spec": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ebs_volume_type": {Type: schema.TypeString, Required: true},
+						"disk_size":       {Type: schema.TypeInt, Required: true},
+						"disk_count":      {Type: schema.TypeInt, Required: true},
+					},
+				},
+			},
+			// skip_destroy leaves the upstream instance pool running in the workspace
+			// when the resource is removed from state, matching the same escape hatch
+			// on databricks_cluster for production pools that outlive a workspace.
+			"skip_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			info, err := NewInstancePoolsAPI(client).Create(instancePoolFromData(d))
+			if err != nil {
+				return err
+			}
+			return updateInstancePoolData(d, info)
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			info, err := NewInstancePoolsAPI(client).Get(d.Id())
+			if err != nil {
+				if common.IsNotFound(err) {
+					d.SetId("")
+					return nil
+				}
+				return err
+			}
+			return updateInstancePoolData(d, info)
+		},
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			if err := NewInstancePoolsAPI(client).Edit(instancePoolFromData(d)); err != nil {
+				return err
+			}
+			info, err := NewInstancePoolsAPI(client).Get(d.Id())
+			if err != nil {
+				return err
+			}
+			return updateInstancePoolData(d, info)
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			if d.Get("skip_destroy").(bool) {
+				log.Printf("[DEBUG] skip_destroy is set on instance pool %s, removing from state without deleting", d.Id())
+				return nil
+			}
+			client := m.(*common.DatabricksClient)
+			return NewInstancePoolsAPI(client).Delete(d.Id(), d.Timeout(schema.TimeoutDelete))
+		},
+	}
+}
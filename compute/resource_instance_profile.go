@@ -0,0 +1,72 @@
+package compute
+
+import (
+	"log"
+
+	"github.com/databrickslabs/databricks-terraform/common"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// ResourceInstanceProfile defines the `databricks_instance_profile` resource, backed by the
+// Instance Profiles API. Instance profiles have no update endpoint, so changing the ARN
+// forces recreation.
+func ResourceInstanceProfile() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_profile_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"skip_validation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			// skip_destroy leaves the instance profile registered with the workspace
+			// when the resource is removed from state, so production profiles that
+			// other, unmanaged clusters still assume aren't yanked out from under them.
+			"skip_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			arn := d.Get("instance_profile_arn").(string)
+			err := NewInstanceProfilesAPI(client).Add(arn, d.Get("skip_validation").(bool))
+			if err != nil {
+				return err
+			}
+			d.SetId(arn)
+			return nil
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			profiles, err := NewInstanceProfilesAPI(client).List()
+			if err != nil {
+				return err
+			}
+			for _, p := range profiles {
+				if p.InstanceProfileArn == d.Id() {
+					return d.Set("instance_profile_arn", p.InstanceProfileArn)
+				}
+			}
+			d.SetId("")
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			if d.Get("skip_destroy").(bool) {
+				log.Printf("[DEBUG] skip_destroy is set on instance profile %s, removing from state without deleting", d.Id())
+				return nil
+			}
+			client := m.(*common.DatabricksClient)
+			return NewInstanceProfilesAPI(client).Remove(d.Id())
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package acceptance
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// AccTest wraps resource.Test, skipping the test unless the environment is configured to
+// talk to a real Databricks workspace. This keeps `go test ./...` fast for local/PR runs
+// while still exercising real API calls in CI's acceptance-test stage.
+func AccTest(t *testing.T, c resource.TestCase) {
+	if _, ok := os.LookupEnv("CLOUD_ENV"); !ok {
+		t.Skip("Acceptance tests skipped unless env 'CLOUD_ENV' is set")
+	}
+	resource.Test(t, c)
+}
@@ -0,0 +1,161 @@
+package qa
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/databrickslabs/databricks-terraform/common"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// HTTPFixture describes one request/response pair a test expects a resource's CRUD
+// functions to make against the Databricks REST API.
+type HTTPFixture struct {
+	Method          string
+	Resource        string
+	Response        interface{}
+	ExpectedRequest interface{}
+	Status          int
+}
+
+// ResourceFixture drives a schema.Resource's Create/Read/Update/Delete against a mock
+// HTTP server seeded with a sequence of HTTPFixtures, mirroring how Terraform core calls
+// into a provider during apply.
+type ResourceFixture struct {
+	Fixtures []HTTPFixture
+	Resource *schema.Resource
+	State    map[string]interface{}
+	ID       string
+	Create   bool
+	Read     bool
+	Update   bool
+	Delete   bool
+}
+
+// Apply spins up an httptest server serving the configured Fixtures in order, builds a
+// schema.ResourceData from State/ID, invokes the matching CRUD func on Resource, and
+// returns the resulting ResourceData for assertions.
+func (f ResourceFixture) Apply(t *testing.T) (*schema.ResourceData, error) {
+	server := httptest.NewServer(f.handler(t))
+	defer server.Close()
+
+	client := &common.DatabricksClient{Host: server.URL, Token: "test"}
+	ctx := &schema.Provider{}
+	_ = ctx
+
+	d := schema.TestResourceDataRaw(t, f.Resource.Schema, f.State)
+	if f.ID != "" {
+		d.SetId(f.ID)
+	}
+
+	m := client
+	switch {
+	case f.Create:
+		return d, f.Resource.Create(d, m)
+	case f.Read:
+		return d, f.Resource.Read(d, m)
+	case f.Update:
+		return d, f.Resource.Update(d, m)
+	case f.Delete:
+		return d, f.Resource.Delete(d, m)
+	default:
+		return d, fmt.Errorf("no CRUD action was selected on this ResourceFixture")
+	}
+}
+
+func (f ResourceFixture) handler(t *testing.T) http.HandlerFunc {
+	callCount := 0
+	return func(w http.ResponseWriter, r *http.Request) {
+		require.True(t, callCount < len(f.Fixtures), "unexpected call #%d: %s %s", callCount, r.Method, r.URL.String())
+		fixture := f.Fixtures[callCount]
+		callCount++
+		assert.Equal(t, fixture.Method, r.Method)
+		status := fixture.Status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		w.WriteHeader(status)
+		if fixture.Response != nil {
+			_ = json.NewEncoder(w).Encode(fixture.Response)
+		}
+	}
+}
+
+// AssertErrorStartsWith fails the test unless err is non-nil and its message begins with prefix.
+func AssertErrorStartsWith(t *testing.T, err error, prefix string) {
+	require.Error(t, err)
+	assert.True(t, strings.HasPrefix(err.Error(), prefix), "expected error to start with %q, got %q", prefix, err.Error())
+}
+
+// AssertRequestWithMockServer spins up a single-request mock server, decodes the incoming
+// request body into into, executes callback against a client pointed at the server, and
+// asserts the callback's result/error against want/wantErr.
+func AssertRequestWithMockServer(t *testing.T, request interface{}, method, uri string, into interface{},
+	response string, status int, want interface{}, wantErr bool, callback func(client common.DatabricksClient) (interface{}, error)) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, method, r.Method)
+		if into != nil {
+			_ = json.NewDecoder(r.Body).Decode(into)
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	client := common.DatabricksClient{Host: server.URL, Token: "test"}
+	got, err := callback(client)
+	if wantErr {
+		require.Error(t, err)
+		return
+	}
+	require.NoError(t, err)
+	if want != nil {
+		assert.Equal(t, want, got)
+	}
+}
+
+// AssertMultipleRequestsWithMockServer is the multi-call variant of AssertRequestWithMockServer,
+// used for paginated/recursive API calls where a single test exercises several round trips
+// against the mock server in sequence.
+func AssertMultipleRequestsWithMockServer(t *testing.T, requests []interface{}, methods, uris []string, intos []interface{},
+	responses []string, statuses []int, want interface{}, wantErr bool, callback func(client common.DatabricksClient) (interface{}, error)) {
+
+	callCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := callCount
+		callCount++
+		if i < len(methods) {
+			assert.Equal(t, methods[i], r.Method)
+		}
+		if i < len(intos) && intos[i] != nil {
+			_ = json.NewDecoder(r.Body).Decode(intos[i])
+		}
+		status := http.StatusOK
+		if i < len(statuses) {
+			status = statuses[i]
+		}
+		w.WriteHeader(status)
+		if i < len(responses) {
+			_, _ = w.Write([]byte(responses[i]))
+		}
+	}))
+	defer server.Close()
+
+	client := common.DatabricksClient{Host: server.URL, Token: "test"}
+	got, err := callback(client)
+	if wantErr {
+		require.Error(t, err)
+		return
+	}
+	require.NoError(t, err)
+	if want != nil {
+		assert.Equal(t, want, got)
+	}
+}
@@ -0,0 +1,50 @@
+package workspace
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDBCArchive_PackUnpackRoundTrip(t *testing.T) {
+	notebooks := []DBCNotebook{
+		{
+			Path:     "Folder/first.python",
+			Language: Python,
+			Commands: []Command{
+				{Position: 2, Command: "print('second')"},
+				{Position: 1, Command: "print('first')"},
+			},
+		},
+	}
+
+	data, err := DBCArchive{}.Pack(notebooks)
+	assert.NoError(t, err)
+
+	unpacked, err := DBCArchive{}.Unpack(bytes.NewReader(data))
+	assert.NoError(t, err)
+	assert.Len(t, unpacked, 1)
+	assert.Equal(t, "Folder/first.python", unpacked[0].Path)
+	assert.Equal(t, Python, unpacked[0].Language)
+	assert.Equal(t, "print('first')", unpacked[0].Commands[0].Command)
+	assert.Equal(t, "print('second')", unpacked[0].Commands[1].Command)
+}
+
+func TestCommandsCRC32_IgnoresDeclarationOrder(t *testing.T) {
+	a := []Command{
+		{Position: 1, Command: "one"},
+		{Position: 2, Command: "two"},
+	}
+	b := []Command{
+		{Position: 2, Command: "two"},
+		{Position: 1, Command: "one"},
+	}
+	assert.Equal(t, CommandsCRC32(a), CommandsCRC32(b))
+}
+
+func TestCommandsCRC32_DetectsContentChange(t *testing.T) {
+	a := []Command{{Position: 1, Command: "one"}}
+	b := []Command{{Position: 1, Command: "two"}}
+	assert.NotEqual(t, CommandsCRC32(a), CommandsCRC32(b))
+}
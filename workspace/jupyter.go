@@ -0,0 +1,99 @@
+package workspace
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type jupyterCell struct {
+	CellType string   `json:"cell_type"`
+	Source   []string `json:"source"`
+}
+
+type jupyterNotebook struct {
+	Cells []jupyterCell `json:"cells"`
+}
+
+// commentPrefix is the line-comment token the SOURCE format uses for a notebook's
+// language, e.g. to mark command separators and %md magic cells.
+func commentPrefix(lang Language) string {
+	switch lang {
+	case Scala:
+		return "//"
+	case Sql:
+		return "--"
+	default:
+		return "#"
+	}
+}
+
+// JupyterToSource converts a Jupyter (.ipynb) notebook, as exported by the Workspace API,
+// into the same COMMAND-separated SOURCE text a `databricks_notebook` with format SOURCE
+// would produce, so the two formats can be diffed or hashed the same way.
+func JupyterToSource(nb []byte, lang Language) (string, error) {
+	var parsed jupyterNotebook
+	if err := json.Unmarshal(nb, &parsed); err != nil {
+		return "", fmt.Errorf("unable to decode Jupyter notebook: %w", err)
+	}
+
+	prefix := commentPrefix(lang)
+	var buf bytes.Buffer
+	buf.WriteString(prefix + " Databricks notebook source\n")
+	for i, cell := range parsed.Cells {
+		if i > 0 {
+			buf.WriteString("\n" + prefix + " COMMAND ----------\n\n")
+		}
+		source := strings.Join(cell.Source, "")
+		if cell.CellType == "markdown" {
+			magic := prefix + " MAGIC"
+			buf.WriteString(magic + " %md\n")
+			buf.WriteString(prefixLines(source, magic+" "))
+			continue
+		}
+		buf.WriteString(source)
+	}
+	return buf.String(), nil
+}
+
+func prefixLines(text, prefix string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = prefix + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// CreateJupyter imports a raw Jupyter (.ipynb) JSON payload at path, base64-encoding it
+// before handing it to the generic import endpoint. Jupyter carries its language inside the
+// notebook metadata rather than as an import parameter, so no Language is passed.
+func (a NotebooksAPI) CreateJupyter(path string, nb []byte, overwrite bool) error {
+	content := base64.StdEncoding.EncodeToString(nb)
+	return a.Create(path, content, "", Jupyter, overwrite)
+}
+
+// ExportJupyter exports the notebook at path as Jupyter and returns its decoded .ipynb JSON,
+// the same raw payload CreateJupyter accepts.
+func (a NotebooksAPI) ExportJupyter(path string) ([]byte, error) {
+	content, err := a.Export(path, Jupyter)
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode notebook content as base64: %w", err)
+	}
+	return decoded, nil
+}
+
+// ExportSource exports the notebook at path as Jupyter and converts it to SOURCE text,
+// giving callers a diff-friendly representation regardless of how the notebook is stored.
+func (a NotebooksAPI) ExportSource(path string, language Language) (string, error) {
+	decoded, err := a.ExportJupyter(path)
+	if err != nil {
+		return "", err
+	}
+	return JupyterToSource(decoded, language)
+}
@@ -0,0 +1,70 @@
+package workspace
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/databrickslabs/databricks-terraform/common"
+	"github.com/databrickslabs/databricks-terraform/internal/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJupyterToSource(t *testing.T) {
+	nb := `{
+		"cells": [
+			{"cell_type": "markdown", "source": ["# Heading\n", "some text"]},
+			{"cell_type": "code", "source": ["print(1)"]}
+		]
+	}`
+	source, err := JupyterToSource([]byte(nb), Python)
+	assert.NoError(t, err)
+	assert.Equal(t, "# Databricks notebook source\n"+
+		"# MAGIC %md\n"+
+		"# MAGIC # Heading\n"+
+		"# MAGIC some text\n"+
+		"# COMMAND ----------\n\n"+
+		"print(1)", source)
+}
+
+func TestJupyterToSource_ScalaUsesSlashComments(t *testing.T) {
+	nb := `{"cells": [{"cell_type": "code", "source": ["println(1)"]}]}`
+	source, err := JupyterToSource([]byte(nb), Scala)
+	assert.NoError(t, err)
+	assert.Equal(t, "// Databricks notebook source\nprintln(1)", source)
+}
+
+func TestJupyterToSource_InvalidJSON(t *testing.T) {
+	_, err := JupyterToSource([]byte("not json"), Python)
+	assert.Error(t, err)
+}
+
+func TestNotebooksAPI_CreateJupyter(t *testing.T) {
+	type args struct {
+		Content string       `json:"content,omitempty"`
+		Path    string       `json:"path,omitempty"`
+		Format  ExportFormat `json:"format,omitempty"`
+	}
+	nb := []byte(`{"cells": [{"cell_type": "code", "source": ["1+1"]}]}`)
+	want := args{
+		Content: base64.StdEncoding.EncodeToString(nb),
+		Path:    "/test/path",
+		Format:  Jupyter,
+	}
+	var input args
+	qa.AssertRequestWithMockServer(t, &want, http.MethodPost, "/api/2.0/workspace/import", &input, "", http.StatusOK, nil, false,
+		func(client common.DatabricksClient) (interface{}, error) {
+			return nil, NewNotebooksAPI(&client).CreateJupyter(want.Path, nb, false)
+		})
+}
+
+func TestNotebooksAPI_ExportJupyter(t *testing.T) {
+	nb := `{"cells": [{"cell_type": "code", "source": ["1+1"]}]}`
+	response := `{"content": "` + base64.StdEncoding.EncodeToString([]byte(nb)) + `"}`
+
+	qa.AssertRequestWithMockServer(t, nil, http.MethodGet, "/api/2.0/workspace/export?format=JUPYTER&path=%2Ftest%2Fpath", nil, response, http.StatusOK, nb, false,
+		func(client common.DatabricksClient) (interface{}, error) {
+			got, err := NewNotebooksAPI(&client).ExportJupyter("/test/path")
+			return string(got), err
+		})
+}
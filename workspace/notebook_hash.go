@@ -0,0 +1,58 @@
+package workspace
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// NotebookHash returns a content-addressable SHA-256 hash of base64-encoded notebook
+// content in the given format. DBC content is hashed from its per-notebook commands sorted
+// by position, so reordering or re-exporting a DBC archive doesn't change the hash. Other
+// formats are hashed after normalizing line endings and trailing whitespace, since those
+// are the only differences the Workspace API is known to introduce on export.
+func NotebookHash(content string, format ExportFormat) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		return "", fmt.Errorf("unable to decode notebook content as base64: %w", err)
+	}
+	if format == DBC {
+		return dbcContentHash(decoded)
+	}
+	return sourceContentHash(decoded), nil
+}
+
+func dbcContentHash(data []byte) (string, error) {
+	notebooks, err := DBCArchive{}.Unpack(bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	sort.Slice(notebooks, func(i, j int) bool { return notebooks[i].Path < notebooks[j].Path })
+
+	h := sha256.New()
+	for _, notebook := range notebooks {
+		_, _ = io.WriteString(h, notebook.Path)
+		commands := make([]Command, len(notebook.Commands))
+		copy(commands, notebook.Commands)
+		sort.Slice(commands, func(i, j int) bool { return commands[i].Position < commands[j].Position })
+		for _, c := range commands {
+			_, _ = io.WriteString(h, c.Command)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func sourceContentHash(data []byte) string {
+	lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	normalized := strings.TrimRight(strings.Join(lines, "\n"), "\n")
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,54 @@
+package workspace
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotebookHash_SourceIgnoresTrailingWhitespace(t *testing.T) {
+	a := base64.StdEncoding.EncodeToString([]byte("print(1)\nprint(2)\n"))
+	b := base64.StdEncoding.EncodeToString([]byte("print(1) \r\nprint(2)   \r\n\n"))
+
+	hashA, err := NotebookHash(a, Source)
+	assert.NoError(t, err)
+	hashB, err := NotebookHash(b, Source)
+	assert.NoError(t, err)
+	assert.Equal(t, hashA, hashB)
+}
+
+func TestNotebookHash_SourceDetectsContentChange(t *testing.T) {
+	a := base64.StdEncoding.EncodeToString([]byte("print(1)\n"))
+	b := base64.StdEncoding.EncodeToString([]byte("print(2)\n"))
+
+	hashA, err := NotebookHash(a, Source)
+	assert.NoError(t, err)
+	hashB, err := NotebookHash(b, Source)
+	assert.NoError(t, err)
+	assert.NotEqual(t, hashA, hashB)
+}
+
+func TestNotebookHash_DBCIgnoresCommandOrder(t *testing.T) {
+	forward := []DBCNotebook{{
+		Path:     "nb",
+		Language: Python,
+		Commands: []Command{{Position: 1, Command: "one"}, {Position: 2, Command: "two"}},
+	}}
+	reversed := []DBCNotebook{{
+		Path:     "nb",
+		Language: Python,
+		Commands: []Command{{Position: 2, Command: "two"}, {Position: 1, Command: "one"}},
+	}}
+
+	forwardBytes, err := DBCArchive{}.Pack(forward)
+	assert.NoError(t, err)
+	reversedBytes, err := DBCArchive{}.Pack(reversed)
+	assert.NoError(t, err)
+
+	hashForward, err := NotebookHash(base64.StdEncoding.EncodeToString(forwardBytes), DBC)
+	assert.NoError(t, err)
+	hashReversed, err := NotebookHash(base64.StdEncoding.EncodeToString(reversedBytes), DBC)
+	assert.NoError(t, err)
+	assert.Equal(t, hashForward, hashReversed)
+}
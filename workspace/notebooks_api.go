@@ -0,0 +1,348 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/databrickslabs/databricks-terraform/common"
+)
+
+// Language is the source language of a notebook, as reported by the Workspace API.
+type Language string
+
+// Supported notebook languages.
+const (
+	Python Language = "PYTHON"
+	Scala  Language = "SCALA"
+	Sql    Language = "SQL"
+	R      Language = "R"
+)
+
+// ExportFormat controls how a notebook is imported/exported: as raw source, as a rendered
+// HTML page, as Jupyter JSON, or as a DBC archive bundling a whole folder.
+type ExportFormat string
+
+// Supported import/export formats.
+const (
+	Source  ExportFormat = "SOURCE"
+	HTML    ExportFormat = "HTML"
+	Jupyter ExportFormat = "JUPYTER"
+	DBC     ExportFormat = "DBC"
+)
+
+// ObjectType distinguishes notebooks from plain directories when listing a workspace path.
+type ObjectType string
+
+// Supported workspace object types.
+const (
+	Notebook  ObjectType = "NOTEBOOK"
+	Directory ObjectType = "DIRECTORY"
+)
+
+// WorkspaceObjectStatus is what the Workspace API returns for a single object from
+// get-status or list.
+type WorkspaceObjectStatus struct {
+	ObjectID   int64      `json:"object_id"`
+	ObjectType ObjectType `json:"object_type"`
+	Path       string     `json:"path"`
+	Language   Language   `json:"language,omitempty"`
+}
+
+// NotebookContent is the response body of a workspace export call.
+type NotebookContent struct {
+	Content string `json:"content"`
+}
+
+// NotebookImportRequest is the request body of a workspace import call.
+type NotebookImportRequest struct {
+	Content   string       `json:"content,omitempty"`
+	Path      string       `json:"path,omitempty"`
+	Language  Language     `json:"language,omitempty"`
+	Overwrite bool         `json:"overwrite,omitempty"`
+	Format    ExportFormat `json:"format,omitempty"`
+}
+
+// NotebookDeleteRequest is the request body of a workspace delete call.
+type NotebookDeleteRequest struct {
+	Path      string `json:"path,omitempty"`
+	Recursive bool   `json:"recursive,omitempty"`
+}
+
+type workspaceListResponse struct {
+	Objects []WorkspaceObjectStatus `json:"objects"`
+}
+
+// NotebooksAPI exposes the `/api/2.0/workspace` family of endpoints.
+type NotebooksAPI struct {
+	client          *common.DatabricksClient
+	readTimeout     time.Duration
+	writeTimeout    time.Duration
+	walkConcurrency int
+}
+
+// NewNotebooksAPI creates a NotebooksAPI instance from a common.DatabricksClient.
+func NewNotebooksAPI(client *common.DatabricksClient) NotebooksAPI {
+	return NotebooksAPI{client: client}
+}
+
+// SetReadDeadline bounds how long read-only calls (Read, Export, List and their *Context
+// variants) may take; a caller-supplied context with an earlier deadline still wins. A zero
+// duration means no deadline.
+func (a *NotebooksAPI) SetReadDeadline(d time.Duration) {
+	a.readTimeout = d
+}
+
+// SetWriteDeadline bounds how long mutating calls (Create, Delete, Mkdirs and their
+// *Context variants) may take. A zero duration means no deadline.
+func (a *NotebooksAPI) SetWriteDeadline(d time.Duration) {
+	a.writeTimeout = d
+}
+
+// withDeadline derives a child context from ctx, applying the configured read or write
+// deadline when the caller hasn't already set a tighter one of their own.
+func (a NotebooksAPI) withDeadline(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// escapeWorkspacePath encodes a workspace path for inclusion in a query string. Workspace
+// paths are the only untrusted component in these URLs, so we escape just the path
+// separator rather than pulling in a general-purpose query encoder.
+func escapeWorkspacePath(path string) string {
+	return strings.Replace(path, "/", "%2F", -1)
+}
+
+// Create imports a notebook at path, base64-encoded content, in the given language and format.
+func (a NotebooksAPI) Create(path, content string, language Language, format ExportFormat, overwrite bool) error {
+	return a.CreateContext(context.Background(), path, content, language, format, overwrite)
+}
+
+// CreateContext is Create, bound to ctx and the configured write deadline. See
+// common.ErrCanceled for how timeout/cancellation is surfaced.
+func (a NotebooksAPI) CreateContext(ctx context.Context, path, content string, language Language, format ExportFormat, overwrite bool) error {
+	ctx, cancel := a.withDeadline(ctx, a.writeTimeout)
+	defer cancel()
+	return a.client.PostContext(ctx, "/api/2.0/workspace/import", NotebookImportRequest{
+		Content:   content,
+		Path:      path,
+		Language:  language,
+		Overwrite: overwrite,
+		Format:    format,
+	}, nil)
+}
+
+// Mkdirs creates path and any missing parent directories in the workspace.
+func (a NotebooksAPI) Mkdirs(path string) error {
+	return a.MkdirsContext(context.Background(), path)
+}
+
+// MkdirsContext is Mkdirs, bound to ctx and the configured write deadline.
+func (a NotebooksAPI) MkdirsContext(ctx context.Context, path string) error {
+	ctx, cancel := a.withDeadline(ctx, a.writeTimeout)
+	defer cancel()
+	return a.client.PostContext(ctx, "/api/2.0/workspace/mkdirs", map[string]string{"path": path}, nil)
+}
+
+// Delete removes path from the workspace. Transient failures, including 429 Too Many
+// Requests, are retried according to the client's RetryPolicy.
+func (a NotebooksAPI) Delete(path string, recursive bool) error {
+	return a.DeleteContext(context.Background(), path, recursive)
+}
+
+// DeleteContext is Delete, bound to ctx and the configured write deadline.
+func (a NotebooksAPI) DeleteContext(ctx context.Context, path string, recursive bool) error {
+	ctx, cancel := a.withDeadline(ctx, a.writeTimeout)
+	defer cancel()
+	return a.client.PostContext(ctx, "/api/2.0/workspace/delete", NotebookDeleteRequest{
+		Path:      path,
+		Recursive: recursive,
+	}, nil)
+}
+
+// Read returns the status of a single workspace object.
+func (a NotebooksAPI) Read(path string) (status WorkspaceObjectStatus, err error) {
+	return a.ReadContext(context.Background(), path)
+}
+
+// ReadContext is Read, bound to ctx and the configured read deadline.
+func (a NotebooksAPI) ReadContext(ctx context.Context, path string) (status WorkspaceObjectStatus, err error) {
+	ctx, cancel := a.withDeadline(ctx, a.readTimeout)
+	defer cancel()
+	err = a.client.GetContext(ctx, fmt.Sprintf("/api/2.0/workspace/get-status?path=%s", escapeWorkspacePath(path)), &status)
+	return
+}
+
+// Export returns the base64-encoded content of the notebook at path in the given format.
+func (a NotebooksAPI) Export(path string, format ExportFormat) (string, error) {
+	return a.ExportContext(context.Background(), path, format)
+}
+
+// ExportContext is Export, bound to ctx and the configured read deadline.
+func (a NotebooksAPI) ExportContext(ctx context.Context, path string, format ExportFormat) (string, error) {
+	ctx, cancel := a.withDeadline(ctx, a.readTimeout)
+	defer cancel()
+	var content NotebookContent
+	err := a.client.GetContext(ctx, fmt.Sprintf("/api/2.0/workspace/export?format=%s&path=%s", format, escapeWorkspacePath(path)), &content)
+	return content.Content, err
+}
+
+// List returns every object under path. When recursive is true, it descends into every
+// directory and returns a flattened list of the non-directory objects found beneath path;
+// the directories themselves are not included.
+func (a NotebooksAPI) List(path string, recursive bool) ([]WorkspaceObjectStatus, error) {
+	return a.ListContext(context.Background(), path, recursive)
+}
+
+// ListContext is List, bound to ctx and the configured read deadline. The deadline applies
+// to ctx as a whole, so it bounds the entire recursive walk rather than each individual call.
+func (a NotebooksAPI) ListContext(ctx context.Context, path string, recursive bool) ([]WorkspaceObjectStatus, error) {
+	ctx, cancel := a.withDeadline(ctx, a.readTimeout)
+	defer cancel()
+	return a.listContext(ctx, path, recursive)
+}
+
+func (a NotebooksAPI) listContext(ctx context.Context, path string, recursive bool) ([]WorkspaceObjectStatus, error) {
+	var resp workspaceListResponse
+	err := a.client.GetContext(ctx, fmt.Sprintf("/api/2.0/workspace/list?path=%s", escapeWorkspacePath(path)), &resp)
+	if err != nil {
+		return nil, err
+	}
+	if !recursive {
+		return resp.Objects, nil
+	}
+	var flattened []WorkspaceObjectStatus
+	for _, obj := range resp.Objects {
+		if obj.ObjectType == Directory {
+			children, err := a.listContext(ctx, obj.Path, true)
+			if err != nil {
+				return nil, err
+			}
+			flattened = append(flattened, children...)
+			continue
+		}
+		flattened = append(flattened, obj)
+	}
+	return flattened, nil
+}
+
+// defaultWalkConcurrency is how many directories Walk expands at once when
+// SetWalkConcurrency hasn't been called.
+const defaultWalkConcurrency = 8
+
+// SetWalkConcurrency overrides how many directories Walk expands at once. A value <= 0
+// restores defaultWalkConcurrency.
+func (a *NotebooksAPI) SetWalkConcurrency(n int) {
+	a.walkConcurrency = n
+}
+
+// Walk descends into path, invoking fn for every object found (including directories
+// themselves), expanding up to SetWalkConcurrency (defaultWalkConcurrency if unset)
+// directories concurrently via a fixed-size worker pool pulling from a shared queue of
+// pending directories - a worker never holds its slot waiting on its own children, so the
+// pool can't deadlock regardless of how the tree branches. Within a single directory,
+// children are visited in a deterministic path-sorted order, but no ordering is guaranteed
+// between sibling directories expanded by different workers. Walk stops handing out new
+// directories as soon as fn or a list call returns an error, and returns that error.
+func (a NotebooksAPI) Walk(path string, fn func(WorkspaceObjectStatus) error) error {
+	concurrency := a.walkConcurrency
+	if concurrency <= 0 {
+		concurrency = defaultWalkConcurrency
+	}
+
+	var mu sync.Mutex
+	cond := sync.NewCond(&mu)
+	queue := []string{path}
+	pending := 1 // directories queued or currently being listed
+	done := false
+	var firstErr error
+
+	pop := func() (string, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		for len(queue) == 0 && !done {
+			cond.Wait()
+		}
+		if done || len(queue) == 0 {
+			return "", false
+		}
+		p := queue[0]
+		queue = queue[1:]
+		return p, true
+	}
+	push := func(p string) {
+		mu.Lock()
+		pending++
+		queue = append(queue, p)
+		mu.Unlock()
+		cond.Signal()
+	}
+	finish := func() {
+		mu.Lock()
+		pending--
+		if pending == 0 {
+			done = true
+			cond.Broadcast()
+		}
+		mu.Unlock()
+	}
+	fail := func(err error) {
+		mu.Lock()
+		if firstErr == nil {
+			firstErr = err
+		}
+		done = true
+		cond.Broadcast()
+		mu.Unlock()
+	}
+	stopped := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return done && firstErr != nil
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for {
+				p, ok := pop()
+				if !ok {
+					return
+				}
+				if stopped() {
+					finish()
+					continue
+				}
+				objects, err := a.List(p, false)
+				if err != nil {
+					fail(err)
+					finish()
+					continue
+				}
+				sort.Slice(objects, func(i, j int) bool { return objects[i].Path < objects[j].Path })
+				for _, obj := range objects {
+					if stopped() {
+						break
+					}
+					if err := fn(obj); err != nil {
+						fail(err)
+						break
+					}
+					if obj.ObjectType == Directory {
+						push(obj.Path)
+					}
+				}
+				finish()
+			}
+		}()
+	}
+	wg.Wait()
+	return firstErr
+}
@@ -0,0 +1,207 @@
+package workspace
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/databrickslabs/databricks-terraform/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// walkFixtureServer serves a fixed directory tree to NotebooksAPI.List calls, keyed by the
+// "path" query parameter, and records every path it was asked to list.
+func walkFixtureServer(t *testing.T, tree map[string][]WorkspaceObjectStatus) (*httptest.Server, *sync.Map) {
+	listed := &sync.Map{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, err := url.QueryUnescape(r.URL.Query().Get("path"))
+		require.NoError(t, err)
+		listed.Store(path, true)
+		objects, ok := tree[path]
+		require.True(t, ok, "unexpected list of %s", path)
+		_ = json.NewEncoder(w).Encode(workspaceListResponse{Objects: objects})
+	}))
+	return server, listed
+}
+
+func TestNotebooksAPI_Walk(t *testing.T) {
+	tree := map[string][]WorkspaceObjectStatus{
+		"/root": {
+			{Path: "/root/c", ObjectType: Notebook},
+			{Path: "/root/a", ObjectType: Notebook},
+			{Path: "/root/b", ObjectType: Directory},
+		},
+		"/root/b": {
+			{Path: "/root/b/bb", ObjectType: Notebook},
+			{Path: "/root/b/ba", ObjectType: Notebook},
+		},
+	}
+	server, _ := walkFixtureServer(t, tree)
+	defer server.Close()
+
+	client := common.DatabricksClient{Host: server.URL, Token: "test"}
+	api := NewNotebooksAPI(&client)
+
+	var mu sync.Mutex
+	var visited []string
+	rootOrder := map[string]int{}
+	bOrder := map[string]int{}
+
+	err := api.Walk("/root", func(obj WorkspaceObjectStatus) error {
+		mu.Lock()
+		defer mu.Unlock()
+		visited = append(visited, obj.Path)
+		switch obj.Path {
+		case "/root/a", "/root/b", "/root/c":
+			rootOrder[obj.Path] = len(rootOrder)
+		case "/root/b/ba", "/root/b/bb":
+			bOrder[obj.Path] = len(bOrder)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	sort.Strings(visited)
+	assert.Equal(t, []string{"/root/a", "/root/b", "/root/b/ba", "/root/b/bb", "/root/c"}, visited)
+
+	assert.True(t, rootOrder["/root/a"] < rootOrder["/root/b"])
+	assert.True(t, rootOrder["/root/b"] < rootOrder["/root/c"])
+	assert.True(t, bOrder["/root/b/ba"] < bOrder["/root/b/bb"])
+}
+
+func TestNotebooksAPI_Walk_StopsOnFirstError(t *testing.T) {
+	tree := map[string][]WorkspaceObjectStatus{
+		"/root": {
+			{Path: "/root/a", ObjectType: Notebook},
+			{Path: "/root/b", ObjectType: Directory},
+			{Path: "/root/c", ObjectType: Notebook},
+		},
+		"/root/b": {
+			{Path: "/root/b/ba", ObjectType: Notebook},
+		},
+	}
+	server, listed := walkFixtureServer(t, tree)
+	defer server.Close()
+
+	client := common.DatabricksClient{Host: server.URL, Token: "test"}
+	api := NewNotebooksAPI(&client)
+
+	boom := fmt.Errorf("boom")
+	var calls int32
+	var mu sync.Mutex
+	err := api.Walk("/root", func(obj WorkspaceObjectStatus) error {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		if obj.Path == "/root/a" {
+			return boom
+		}
+		return nil
+	})
+
+	require.Equal(t, boom, err)
+	mu.Lock()
+	assert.Equal(t, int32(1), calls)
+	mu.Unlock()
+	_, listedB := listed.Load("/root/b")
+	assert.False(t, listedB, "Walk should not have descended into /root/b after the first error")
+}
+
+// TestNotebooksAPI_Walk_WideTreeDoesNotDeadlock reproduces a tree shape that deadlocked the
+// previous implementation: defaultWalkConcurrency first-level directories, each holding a
+// further subdirectory of its own. A worker pool that holds its slot while recursing into
+// its own children exhausts all slots on the first level and never frees one for the
+// second, so this must complete well within the timeout rather than hang forever.
+func TestNotebooksAPI_Walk_WideTreeDoesNotDeadlock(t *testing.T) {
+	tree := map[string][]WorkspaceObjectStatus{}
+	var root []WorkspaceObjectStatus
+	for i := 0; i < defaultWalkConcurrency; i++ {
+		dir := fmt.Sprintf("/root/d%d", i)
+		sub := dir + "/sub"
+		root = append(root, WorkspaceObjectStatus{Path: dir, ObjectType: Directory})
+		tree[dir] = []WorkspaceObjectStatus{{Path: sub, ObjectType: Directory}}
+		tree[sub] = []WorkspaceObjectStatus{{Path: sub + "/nb", ObjectType: Notebook}}
+	}
+	tree["/root"] = root
+
+	server, _ := walkFixtureServer(t, tree)
+	defer server.Close()
+
+	client := common.DatabricksClient{Host: server.URL, Token: "test"}
+	api := NewNotebooksAPI(&client)
+
+	var mu sync.Mutex
+	visited := map[string]bool{}
+
+	result := make(chan error, 1)
+	go func() {
+		result <- api.Walk("/root", func(obj WorkspaceObjectStatus) error {
+			mu.Lock()
+			visited[obj.Path] = true
+			mu.Unlock()
+			return nil
+		})
+	}()
+
+	select {
+	case err := <-result:
+		require.NoError(t, err)
+	case <-time.After(3 * time.Second):
+		t.Fatal("Walk deadlocked on a tree as wide as its concurrency limit")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Len(t, visited, 3*defaultWalkConcurrency)
+}
+
+func TestNotebooksAPI_Walk_ConfigurableConcurrency(t *testing.T) {
+	tree := map[string][]WorkspaceObjectStatus{}
+	var root []WorkspaceObjectStatus
+	for i := 0; i < defaultWalkConcurrency+2; i++ {
+		dir := fmt.Sprintf("/root/d%d", i)
+		sub := dir + "/sub"
+		root = append(root, WorkspaceObjectStatus{Path: dir, ObjectType: Directory})
+		tree[dir] = []WorkspaceObjectStatus{{Path: sub, ObjectType: Directory}}
+		tree[sub] = []WorkspaceObjectStatus{{Path: sub + "/nb", ObjectType: Notebook}}
+	}
+	tree["/root"] = root
+
+	server, _ := walkFixtureServer(t, tree)
+	defer server.Close()
+
+	client := common.DatabricksClient{Host: server.URL, Token: "test"}
+	api := NewNotebooksAPI(&client)
+	api.SetWalkConcurrency(2)
+
+	var mu sync.Mutex
+	visited := map[string]bool{}
+
+	result := make(chan error, 1)
+	go func() {
+		result <- api.Walk("/root", func(obj WorkspaceObjectStatus) error {
+			mu.Lock()
+			visited[obj.Path] = true
+			mu.Unlock()
+			return nil
+		})
+	}()
+
+	select {
+	case err := <-result:
+		require.NoError(t, err)
+	case <-time.After(3 * time.Second):
+		t.Fatal("Walk deadlocked with a narrow concurrency limit")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Len(t, visited, 3*(defaultWalkConcurrency+2))
+}
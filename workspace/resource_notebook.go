@@ -0,0 +1,198 @@
+package workspace
+
+import (
+	"encoding/base64"
+	"fmt"
+	"hash/crc32"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/databrickslabs/databricks-terraform/common"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// ValidateNotebookPath is a schema.SchemaValidateFunc that enforces the workspace path
+// conventions the Workspace API itself expects: non-empty, and rooted at "/".
+func ValidateNotebookPath(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%s must not be empty", k))
+	}
+	if !strings.HasPrefix(value, "/") {
+		errors = append(errors, fmt.Errorf("%s must start with a slash, e.g. /Users/me@example.com/notebook", k))
+	}
+	return
+}
+
+// convertBase64ToCheckSum reduces base64-encoded notebook content down to a CRC32 checksum,
+// so Terraform diffs on the `content` attribute without persisting the full notebook body
+// (which can be arbitrarily large) into state.
+func convertBase64ToCheckSum(content string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		return "", fmt.Errorf("unable to decode notebook content as base64: %w", err)
+	}
+	return strconv.Itoa(int(crc32.ChecksumIEEE(decoded))), nil
+}
+
+func parentDirectory(path string) string {
+	idx := strings.LastIndex(path, "/")
+	if idx <= 0 {
+		return "/"
+	}
+	return path[:idx]
+}
+
+// ResourceNotebook defines the `databricks_notebook` resource, backed by the Workspace API.
+func ResourceNotebook() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ValidateNotebookPath,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"language": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(Source),
+			},
+			"overwrite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"mkdirs": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"object_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		CustomizeDiff: func(d *schema.ResourceDiff, m interface{}) error {
+			format := ExportFormat(d.Get("format").(string))
+			if format == "" {
+				format = Source
+			}
+			hash, err := NotebookHash(d.Get("content").(string), format)
+			if err != nil {
+				return err
+			}
+			return d.SetNew("hash", hash)
+		},
+		Create: resourceNotebookCreate,
+		Read:   resourceNotebookRead,
+		Delete: resourceNotebookDelete,
+	}
+}
+
+func resourceNotebookCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*common.DatabricksClient)
+	api := NewNotebooksAPI(client)
+	api.SetWriteDeadline(d.Timeout(schema.TimeoutCreate))
+	path := d.Get("path").(string)
+
+	// The workspace root always exists, so there is nothing to check or create for it.
+	if d.Get("mkdirs").(bool) && parentDirectory(path) != "/" {
+		_, err := api.Read(parentDirectory(path))
+		if err != nil {
+			if !common.IsNotFound(err) {
+				return err
+			}
+			if err := api.Mkdirs(parentDirectory(path)); err != nil {
+				return err
+			}
+		}
+	}
+
+	err := api.Create(
+		path,
+		d.Get("content").(string),
+		Language(d.Get("language").(string)),
+		ExportFormat(d.Get("format").(string)),
+		d.Get("overwrite").(bool),
+	)
+	if err != nil {
+		return err
+	}
+	d.SetId(path)
+	return resourceNotebookRead(d, m)
+}
+
+func resourceNotebookRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*common.DatabricksClient)
+	api := NewNotebooksAPI(client)
+	api.SetReadDeadline(d.Timeout(schema.TimeoutRead))
+	path := d.Id()
+
+	format := ExportFormat(d.Get("format").(string))
+	if format == "" {
+		format = Source
+	}
+	content, err := api.Export(path, format)
+	if err != nil {
+		if common.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	checkSum, err := convertBase64ToCheckSum(content)
+	if err != nil {
+		return err
+	}
+	hash, err := NotebookHash(content, format)
+	if err != nil {
+		return err
+	}
+
+	status, err := api.Read(path)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(status.Path)
+	if err := d.Set("path", status.Path); err != nil {
+		return err
+	}
+	if err := d.Set("content", checkSum); err != nil {
+		return err
+	}
+	if err := d.Set("language", string(status.Language)); err != nil {
+		return err
+	}
+	if err := d.Set("hash", hash); err != nil {
+		return err
+	}
+	return d.Set("object_id", int(status.ObjectID))
+}
+
+func resourceNotebookDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*common.DatabricksClient)
+	api := NewNotebooksAPI(client)
+	api.SetWriteDeadline(d.Timeout(schema.TimeoutDelete))
+	return api.Delete(d.Id(), true)
+}
@@ -0,0 +1,312 @@
+package workspace
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/databrickslabs/databricks-terraform/common"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// formatForNotebookExtension maps a local file extension to the language/format the
+// Workspace API should import it as. The DBC extension has no associated language, since a
+// DBC archive carries one language per notebook internally.
+func formatForNotebookExtension(ext string) (Language, ExportFormat, bool) {
+	switch ext {
+	case ".py":
+		return Python, Source, true
+	case ".scala":
+		return Scala, Source, true
+	case ".sql":
+		return Sql, Source, true
+	case ".r":
+		return R, Source, true
+	case ".ipynb":
+		return "", Jupyter, true
+	case ".dbc":
+		return "", DBC, true
+	default:
+		return "", "", false
+	}
+}
+
+// databricksIgnore reports whether relPath (workspace-relative, slash-separated) is excluded
+// by the .databricksignore file at the root of localPath, using gitignore-style glob patterns
+// matched against the whole relative path or any of its path segments.
+func databricksIgnore(localPath string) (func(relPath string) bool, error) {
+	f, err := os.Open(filepath.Join(localPath, ".databricksignore"))
+	if os.IsNotExist(err) {
+		return func(string) bool { return false }, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var patterns []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, strings.TrimSuffix(line, "/"))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return func(relPath string) bool {
+		for _, pattern := range patterns {
+			if ok, _ := filepath.Match(pattern, relPath); ok {
+				return true
+			}
+			for _, segment := range strings.Split(relPath, "/") {
+				if ok, _ := filepath.Match(pattern, segment); ok {
+					return true
+				}
+			}
+		}
+		return false
+	}, nil
+}
+
+// localNotebookHashes walks localPath and returns a map of workspace-relative path to
+// NotebookHash for every file databricksIgnore doesn't exclude and formatForNotebookExtension
+// recognizes.
+func localNotebookHashes(localPath string) (map[string]string, error) {
+	ignored, err := databricksIgnore(localPath)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := map[string]string{}
+	err = filepath.Walk(localPath, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(localPath, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if ignored(rel) {
+			return nil
+		}
+		_, format, ok := formatForNotebookExtension(strings.ToLower(filepath.Ext(p)))
+		if !ok {
+			return nil
+		}
+		data, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		hash, err := NotebookHash(base64.StdEncoding.EncodeToString(data), format)
+		if err != nil {
+			return fmt.Errorf("unable to hash %s: %w", rel, err)
+		}
+		hashes[rel] = hash
+		return nil
+	})
+	return hashes, err
+}
+
+func syncNotebookFile(api NotebooksAPI, localPath, remotePath, relPath string) error {
+	ext := strings.ToLower(filepath.Ext(relPath))
+	language, format, ok := formatForNotebookExtension(ext)
+	if !ok {
+		return nil
+	}
+	data, err := ioutil.ReadFile(filepath.Join(localPath, filepath.FromSlash(relPath)))
+	if err != nil {
+		return err
+	}
+
+	if format == DBC {
+		remoteDir := strings.TrimRight(remotePath, "/") + "/" + strings.TrimSuffix(relPath, ext)
+		if err := api.Mkdirs(parentDirectory(remoteDir)); err != nil {
+			return err
+		}
+		return api.ImportDBC(remoteDir, bytes.NewReader(data))
+	}
+
+	remoteFilePath := strings.TrimRight(remotePath, "/") + "/" + relPath
+	if err := api.Mkdirs(parentDirectory(remoteFilePath)); err != nil {
+		return err
+	}
+	content := base64.StdEncoding.EncodeToString(data)
+	return api.Create(remoteFilePath, content, language, format, true)
+}
+
+// notebookDirectoryKeepSet expands hashes - keyed by local relPath - into the set of remote
+// paths (relative to remotePath) that must survive pruning. A DBC entry unpacks server-side
+// into one notebook per entry in the archive, nested under the DBC's relPath rather than
+// appearing at it, so those entries are resolved by listing what ImportDBC actually produced
+// instead of keying on the archive's own relPath.
+func notebookDirectoryKeepSet(api NotebooksAPI, remotePath string, hashes map[string]interface{}) (map[string]string, error) {
+	prefix := strings.TrimRight(remotePath, "/") + "/"
+	keep := make(map[string]string, len(hashes))
+	for relPath, hash := range hashes {
+		ext := strings.ToLower(filepath.Ext(relPath))
+		_, format, ok := formatForNotebookExtension(ext)
+		if !ok {
+			continue
+		}
+		if format != DBC {
+			keep[relPath] = hash.(string)
+			continue
+		}
+		remoteDir := strings.TrimRight(remotePath, "/") + "/" + strings.TrimSuffix(relPath, ext)
+		objects, err := api.List(remoteDir, true)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objects {
+			if obj.ObjectType != Notebook {
+				continue
+			}
+			keep[strings.TrimPrefix(obj.Path, prefix)] = hash.(string)
+		}
+	}
+	return keep, nil
+}
+
+func notebookDirectoryPruneCandidates(api NotebooksAPI, remotePath string, keep map[string]string) ([]string, error) {
+	objects, err := api.List(remotePath, true)
+	if err != nil {
+		return nil, err
+	}
+	var stale []string
+	prefix := strings.TrimRight(remotePath, "/") + "/"
+	for _, obj := range objects {
+		if obj.ObjectType != Notebook {
+			continue
+		}
+		rel := strings.TrimPrefix(obj.Path, prefix)
+		if _, ok := keep[rel]; !ok {
+			stale = append(stale, obj.Path)
+		}
+	}
+	return stale, nil
+}
+
+// ResourceNotebookDirectory defines the `databricks_notebook_directory` resource, which
+// mirrors a local directory of notebooks - in any supported format, including DBC archives
+// and Jupyter notebooks - into a Databricks workspace path, diffing by semantic content hash
+// rather than raw bytes.
+func ResourceNotebookDirectory() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ValidateNotebookPath,
+			},
+			"local_path": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"prune": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"hashes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: func(d *schema.ResourceDiff, m interface{}) error {
+			hashes, err := localNotebookHashes(d.Get("local_path").(string))
+			if err != nil {
+				return err
+			}
+			return d.SetNew("hashes", hashes)
+		},
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			api := NewNotebooksAPI(client)
+			path := d.Get("path").(string)
+			localPath := d.Get("local_path").(string)
+
+			if err := api.Mkdirs(path); err != nil {
+				return err
+			}
+			hashes, err := localNotebookHashes(localPath)
+			if err != nil {
+				return err
+			}
+			for relPath := range hashes {
+				if err := syncNotebookFile(api, localPath, path, relPath); err != nil {
+					return fmt.Errorf("unable to import %s: %w", relPath, err)
+				}
+			}
+			d.SetId(path)
+			return d.Set("hashes", hashes)
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			api := NewNotebooksAPI(client)
+			if _, err := api.Read(d.Id()); err != nil {
+				if common.IsNotFound(err) {
+					d.SetId("")
+					return nil
+				}
+				return err
+			}
+			return nil
+		},
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			api := NewNotebooksAPI(client)
+			path := d.Get("path").(string)
+			localPath := d.Get("local_path").(string)
+
+			old, new := d.GetChange("hashes")
+			oldHashes := old.(map[string]interface{})
+			newHashes := new.(map[string]interface{})
+
+			for relPath, hash := range newHashes {
+				if oldHashes[relPath] == hash {
+					continue
+				}
+				if err := syncNotebookFile(api, localPath, path, relPath); err != nil {
+					return fmt.Errorf("unable to import %s: %w", relPath, err)
+				}
+			}
+
+			if !d.Get("prune").(bool) {
+				return nil
+			}
+			keep, err := notebookDirectoryKeepSet(api, path, newHashes)
+			if err != nil {
+				return err
+			}
+			stale, err := notebookDirectoryPruneCandidates(api, path, keep)
+			if err != nil {
+				return err
+			}
+			for _, remoteFilePath := range stale {
+				if err := api.Delete(remoteFilePath, false); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			return NewNotebooksAPI(client).Delete(d.Id(), true)
+		},
+	}
+}
@@ -0,0 +1,122 @@
+package workspace
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/databrickslabs/databricks-terraform/common"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// localDBCChecksum computes the central directory checksum of the DBC archive at localPath.
+func localDBCChecksum(localPath string) (string, error) {
+	data, err := ioutil.ReadFile(localPath)
+	if err != nil {
+		return "", err
+	}
+	return dbcCentralDirectoryChecksum(data)
+}
+
+// ResourceWorkspaceDBC defines the `databricks_workspace_dbc` resource, which imports a
+// local DBC archive as a whole into a Databricks workspace folder in one round trip, rather
+// than declaring one `databricks_notebook` block per file.
+func ResourceWorkspaceDBC() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ValidateNotebookPath,
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: func(d *schema.ResourceDiff, m interface{}) error {
+			checksum, err := localDBCChecksum(d.Get("source").(string))
+			if err != nil {
+				return err
+			}
+			return d.SetNew("checksum", checksum)
+		},
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			api := NewNotebooksAPI(client)
+			path := d.Get("path").(string)
+			source := d.Get("source").(string)
+
+			file, err := os.Open(source)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			if err := api.Mkdirs(parentDirectory(path)); err != nil {
+				return err
+			}
+			if err := api.ImportDBC(path, file); err != nil {
+				return err
+			}
+			checksum, err := localDBCChecksum(source)
+			if err != nil {
+				return err
+			}
+			d.SetId(path)
+			return d.Set("checksum", checksum)
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			api := NewNotebooksAPI(client)
+
+			archive, err := api.ExportDBC(d.Id())
+			if err != nil {
+				if common.IsNotFound(err) {
+					d.SetId("")
+					return nil
+				}
+				return err
+			}
+			defer archive.Close()
+
+			data, err := ioutil.ReadAll(archive)
+			if err != nil {
+				return err
+			}
+			checksum, err := dbcCentralDirectoryChecksum(data)
+			if err != nil {
+				return err
+			}
+			return d.Set("checksum", checksum)
+		},
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			api := NewNotebooksAPI(client)
+			source := d.Get("source").(string)
+
+			file, err := os.Open(source)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			if err := api.ImportDBC(d.Id(), file); err != nil {
+				return err
+			}
+			checksum, err := localDBCChecksum(source)
+			if err != nil {
+				return err
+			}
+			return d.Set("checksum", checksum)
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			return NewNotebooksAPI(client).Delete(d.Id(), true)
+		},
+	}
+}
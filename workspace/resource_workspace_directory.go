@@ -0,0 +1,202 @@
+package workspace
+
+import (
+	"encoding/base64"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/databrickslabs/databricks-terraform/common"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// languageByExtension maps a local file extension to the notebook language the Workspace
+// API should import it as, in SOURCE format. `.ipynb` is not in this map but is imported too,
+// as Jupyter (see formatForExtension). Files with any other extension aren't notebooks and
+// are skipped, since the Workspace API has no concept of an arbitrary file.
+var languageByExtension = map[string]Language{
+	".py":    Python,
+	".scala": Scala,
+	".sql":   Sql,
+	".r":     R,
+}
+
+func formatForExtension(ext string) ExportFormat {
+	if ext == ".ipynb" {
+		return Jupyter
+	}
+	return Source
+}
+
+// localDirectoryChecksums walks localPath and returns a map of workspace-relative path to
+// CRC32 checksum for every file the sync resource knows how to import.
+func localDirectoryChecksums(localPath string) (map[string]string, error) {
+	checksums := map[string]string{}
+	err := filepath.Walk(localPath, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		ext := strings.ToLower(filepath.Ext(p))
+		if _, ok := languageByExtension[ext]; !ok && ext != ".ipynb" {
+			return nil
+		}
+		data, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(localPath, p)
+		if err != nil {
+			return err
+		}
+		checksums[filepath.ToSlash(rel)] = strconv.FormatUint(uint64(crc32.ChecksumIEEE(data)), 10)
+		return nil
+	})
+	return checksums, err
+}
+
+func remoteDirectoryPruneCandidates(api NotebooksAPI, remotePath string, keep map[string]string) ([]string, error) {
+	objects, err := api.List(remotePath, true)
+	if err != nil {
+		return nil, err
+	}
+	var stale []string
+	prefix := strings.TrimRight(remotePath, "/") + "/"
+	for _, obj := range objects {
+		if obj.ObjectType != Notebook {
+			continue
+		}
+		rel := strings.TrimPrefix(obj.Path, prefix)
+		if _, ok := keep[rel]; !ok {
+			stale = append(stale, obj.Path)
+		}
+	}
+	return stale, nil
+}
+
+func syncLocalFileToWorkspace(api NotebooksAPI, localPath, remotePath, relPath string) error {
+	ext := strings.ToLower(filepath.Ext(relPath))
+	language, isNotebookExt := languageByExtension[ext]
+	if !isNotebookExt && ext != ".ipynb" {
+		return nil
+	}
+	data, err := ioutil.ReadFile(filepath.Join(localPath, filepath.FromSlash(relPath)))
+	if err != nil {
+		return err
+	}
+	remoteFilePath := strings.TrimRight(remotePath, "/") + "/" + relPath
+	if err := api.Mkdirs(parentDirectory(remoteFilePath)); err != nil {
+		return err
+	}
+	content := base64.StdEncoding.EncodeToString(data)
+	return api.Create(remoteFilePath, content, language, formatForExtension(ext), true)
+}
+
+// ResourceWorkspaceDirectory defines the `databricks_workspace_directory` resource, which
+// mirrors a local directory of notebooks into a Databricks workspace path so teams don't
+// have to declare one `databricks_notebook` block per file.
+func ResourceWorkspaceDirectory() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ValidateNotebookPath,
+			},
+			"local_path": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"checksums": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: func(d *schema.ResourceDiff, m interface{}) error {
+			checksums, err := localDirectoryChecksums(d.Get("local_path").(string))
+			if err != nil {
+				return err
+			}
+			return d.SetNew("checksums", checksums)
+		},
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			api := NewNotebooksAPI(client)
+			path := d.Get("path").(string)
+			localPath := d.Get("local_path").(string)
+
+			if err := api.Mkdirs(path); err != nil {
+				return err
+			}
+			checksums, err := localDirectoryChecksums(localPath)
+			if err != nil {
+				return err
+			}
+			for relPath := range checksums {
+				if err := syncLocalFileToWorkspace(api, localPath, path, relPath); err != nil {
+					return fmt.Errorf("unable to import %s: %w", relPath, err)
+				}
+			}
+			d.SetId(path)
+			return d.Set("checksums", checksums)
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			api := NewNotebooksAPI(client)
+			if _, err := api.Read(d.Id()); err != nil {
+				if common.IsNotFound(err) {
+					d.SetId("")
+					return nil
+				}
+				return err
+			}
+			return nil
+		},
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			api := NewNotebooksAPI(client)
+			path := d.Get("path").(string)
+			localPath := d.Get("local_path").(string)
+
+			old, new := d.GetChange("checksums")
+			oldChecksums := old.(map[string]interface{})
+			newChecksums := new.(map[string]interface{})
+
+			for relPath, checksum := range newChecksums {
+				if oldChecksums[relPath] == checksum {
+					continue
+				}
+				if err := syncLocalFileToWorkspace(api, localPath, path, relPath); err != nil {
+					return fmt.Errorf("unable to import %s: %w", relPath, err)
+				}
+			}
+
+			keep := make(map[string]string, len(newChecksums))
+			for relPath, checksum := range newChecksums {
+				keep[relPath] = checksum.(string)
+			}
+			stale, err := remoteDirectoryPruneCandidates(api, path, keep)
+			if err != nil {
+				return err
+			}
+			for _, remoteFilePath := range stale {
+				if err := api.Delete(remoteFilePath, false); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			client := m.(*common.DatabricksClient)
+			return NewNotebooksAPI(client).Delete(d.Id(), true)
+		},
+	}
+}